@@ -0,0 +1,141 @@
+package jetton
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/semaphore"
+
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/ton"
+)
+
+// BatchError collects the per-address failures from a batch call. Callers
+// that only care whether everything succeeded can still treat it as a plain
+// error; callers that need per-address detail can type-assert it back.
+type BatchError map[string]error
+
+func (e BatchError) Error() string {
+	parts := make([]string, 0, len(e))
+	for addr, err := range e {
+		parts = append(parts, fmt.Sprintf("%s: %s", addr, err))
+	}
+	return fmt.Sprintf("%d address(es) failed: %s", len(e), strings.Join(parts, "; "))
+}
+
+// GetMastersData fetches MasterData for each of masters using the package's
+// default Client. See Client.GetMastersData.
+func GetMastersData(ctx context.Context, api ton.APIClientWrapped, masters []*address.Address) (map[string]*MasterData, error) {
+	return defaultClient.GetMastersData(ctx, api, masters)
+}
+
+// GetMastersData fans out GetMasterData over masters with bounded
+// concurrency. Concurrent lookups for the same address are deduplicated via
+// singleflight, so they share a single TON RPC call and, where applicable, a
+// single off-chain HTTP fetch. A failure for one address does not fail the
+// others; per-address failures are returned as a BatchError.
+func (c *Client) GetMastersData(ctx context.Context, api ton.APIClientWrapped, masters []*address.Address) (map[string]*MasterData, error) {
+	results := make(map[string]*MasterData, len(masters))
+	errs := make(BatchError)
+	var mu sync.Mutex
+
+	sem := semaphore.NewWeighted(int64(c.batchConcurrency))
+	var wg sync.WaitGroup
+
+	for _, master := range masters {
+		master := master
+		key := master.String()
+
+		if err := sem.Acquire(ctx, 1); err != nil {
+			mu.Lock()
+			errs[key] = err
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer sem.Release(1)
+
+			v, err, _ := c.masterGroup.Do(key, func() (interface{}, error) {
+				return c.GetMasterData(ctx, api, master)
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[key] = err
+				return
+			}
+			results[key] = v.(*MasterData)
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return results, nil
+	}
+	return results, errs
+}
+
+// GetMastersByWallets fetches MasterData for each wallet's master using the
+// package's default Client. See Client.GetMastersByWallets.
+func GetMastersByWallets(ctx context.Context, api ton.APIClientWrapped, wallets []*address.Address) (map[string]*MasterData, error) {
+	return defaultClient.GetMastersByWallets(ctx, api, wallets)
+}
+
+// GetMastersByWallets fans out GetMasterByWallet over wallets with bounded
+// concurrency and singleflight deduplication, keyed by wallet address. This
+// is the entry point indexers should use to enrich transactions with jetton
+// metadata: many wallets across a block commonly share a master, and
+// GetMasterByWallet deduplicates its GetMasterData call per master, so that
+// RPC call and off-chain fetch are shared across them too.
+func (c *Client) GetMastersByWallets(ctx context.Context, api ton.APIClientWrapped, wallets []*address.Address) (map[string]*MasterData, error) {
+	results := make(map[string]*MasterData, len(wallets))
+	errs := make(BatchError)
+	var mu sync.Mutex
+
+	sem := semaphore.NewWeighted(int64(c.batchConcurrency))
+	var wg sync.WaitGroup
+
+	for _, wallet := range wallets {
+		wallet := wallet
+		key := wallet.String()
+
+		if err := sem.Acquire(ctx, 1); err != nil {
+			mu.Lock()
+			errs[key] = err
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer sem.Release(1)
+
+			v, err, _ := c.walletGroup.Do(key, func() (interface{}, error) {
+				return c.GetMasterByWallet(ctx, api, wallet)
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[key] = err
+				return
+			}
+			results[key] = v.(*MasterData)
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return results, nil
+	}
+	return results, errs
+}
@@ -0,0 +1,78 @@
+package jetton
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/ton"
+)
+
+func TestBatchErrorFormatting(t *testing.T) {
+	errs := BatchError{
+		"addrA": errors.New("boom"),
+	}
+
+	got := errs.Error()
+	want := "1 address(es) failed: addrA: boom"
+	if got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+// GetMastersData and GetMastersByWallets fan out to a real TON RPC client
+// per address, so a canceled context is the one failure mode this package
+// can exercise without a stub of the whole ton.APIClientWrapped surface: it
+// proves the semaphore-acquire failure path populates a per-address
+// BatchError instead of failing the whole batch.
+func TestGetMastersDataCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := NewClient()
+	var api ton.APIClientWrapped
+
+	masters := []*address.Address{testAddr(1), testAddr(2)}
+
+	results, err := c.GetMastersData(ctx, api, masters)
+	if len(results) != 0 {
+		t.Errorf("results = %v, want empty", results)
+	}
+
+	var batchErr BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("err = %v, want BatchError", err)
+	}
+	if len(batchErr) != len(masters) {
+		t.Errorf("BatchError has %d entries, want %d", len(batchErr), len(masters))
+	}
+	for _, master := range masters {
+		if _, ok := batchErr[master.String()]; !ok {
+			t.Errorf("BatchError missing entry for %s", master)
+		}
+	}
+}
+
+func TestGetMastersByWalletsCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := NewClient()
+	var api ton.APIClientWrapped
+
+	wallets := []*address.Address{testAddr(1), testAddr(2)}
+
+	results, err := c.GetMastersByWallets(ctx, api, wallets)
+	if len(results) != 0 {
+		t.Errorf("results = %v, want empty", results)
+	}
+
+	var batchErr BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("err = %v, want BatchError", err)
+	}
+	if len(batchErr) != len(wallets) {
+		t.Errorf("BatchError has %d entries, want %d", len(batchErr), len(wallets))
+	}
+}
@@ -0,0 +1,38 @@
+package jetton
+
+import (
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+// Cache is the storage backend ContentResolver uses to avoid re-fetching
+// off-chain content for the same URI. Implementations must be safe for
+// concurrent use; plug in Redis, BigCache, an LRU, or anything else behind
+// this interface via WithCache.
+type Cache interface {
+	Get(key string) (*OffchainContent, bool)
+	Set(key string, value *OffchainContent, ttl time.Duration)
+}
+
+// memoryCache is the default Cache, preserving the package's original
+// in-process go-cache behaviour.
+type memoryCache struct {
+	c *gocache.Cache
+}
+
+func newMemoryCache(defaultExpiration, cleanupInterval time.Duration) *memoryCache {
+	return &memoryCache{c: gocache.New(defaultExpiration, cleanupInterval)}
+}
+
+func (m *memoryCache) Get(key string) (*OffchainContent, bool) {
+	v, ok := m.c.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return v.(*OffchainContent), true
+}
+
+func (m *memoryCache) Set(key string, value *OffchainContent, ttl time.Duration) {
+	m.c.Set(key, value, ttl)
+}
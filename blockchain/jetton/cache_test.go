@@ -0,0 +1,25 @@
+package jetton
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	c := newMemoryCache(time.Minute, time.Minute)
+
+	if _, ok := c.Get("missing"); ok {
+		t.Fatal("Get on empty cache returned ok=true")
+	}
+
+	want := &OffchainContent{Name: "Foo"}
+	c.Set("uri", want, time.Minute)
+
+	got, ok := c.Get("uri")
+	if !ok {
+		t.Fatal("Get after Set returned ok=false")
+	}
+	if got != want {
+		t.Errorf("Get returned %v, want the same pointer stored by Set", got)
+	}
+}
@@ -0,0 +1,186 @@
+package jetton
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	defaultHTTPTimeout      = 10 * time.Second
+	defaultMaxBodySize      = 2 << 20 // 2 MiB, enough for metadata JSON while bounding malicious payloads
+	defaultCacheTTL         = time.Hour
+	defaultCacheExpiration  = 5 * time.Minute
+	defaultCacheCleanup     = 10 * time.Minute
+	defaultArweaveGateway   = "https://arweave.net"
+	defaultBatchConcurrency = 16
+)
+
+var defaultIPFSGateways = []string{
+	"https://ipfs.io/ipfs",
+	"https://cloudflare-ipfs.com/ipfs",
+}
+
+// Client resolves jetton master metadata, including off-chain content
+// fetched through a pluggable ContentResolver. The zero value is not usable;
+// construct one with NewClient.
+type Client struct {
+	resolver         *ContentResolver
+	logger           Logger
+	batchConcurrency int
+	masterGroup      singleflight.Group
+	walletGroup      singleflight.Group
+}
+
+// Option configures a Client built with NewClient.
+type Option func(*clientConfig)
+
+type clientConfig struct {
+	httpTimeout      time.Duration
+	maxBodySize      int64
+	cacheTTL         time.Duration
+	cache            Cache
+	logger           Logger
+	ipfsGateways     []string
+	arGateway        string
+	fetchers         map[string]URIFetcher
+	batchConcurrency int
+}
+
+// WithFetcher registers a custom URIFetcher for the given URI scheme
+// (e.g. "ipfs", "ar"), overriding the built-in one if any. "http" and
+// "https" are dispatched through the same fetcher, so registering either
+// one registers both.
+func WithFetcher(scheme string, fetcher URIFetcher) Option {
+	return func(c *clientConfig) {
+		c.fetchers[scheme] = fetcher
+		if scheme == "http" || scheme == "https" {
+			c.fetchers["http"] = fetcher
+			c.fetchers["https"] = fetcher
+		}
+	}
+}
+
+// WithCache overrides the default in-memory Cache, e.g. with a Redis or
+// BigCache backed implementation.
+func WithCache(cache Cache) Option {
+	return func(c *clientConfig) {
+		c.cache = cache
+	}
+}
+
+// WithCacheTTL sets how long resolved off-chain content is cached for.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(c *clientConfig) {
+		c.cacheTTL = ttl
+	}
+}
+
+// WithHTTPTimeout sets the timeout used by the built-in http(s) and
+// ipfs/ar gateway fetchers.
+func WithHTTPTimeout(timeout time.Duration) Option {
+	return func(c *clientConfig) {
+		c.httpTimeout = timeout
+	}
+}
+
+// WithMaxBodySize bounds how many bytes are read from an off-chain content
+// response, protecting against memory exhaustion from malicious metadata
+// URIs. A value <= 0 disables the limit.
+func WithMaxBodySize(maxBodySize int64) Option {
+	return func(c *clientConfig) {
+		c.maxBodySize = maxBodySize
+	}
+}
+
+// WithIPFSGateways overrides the list of IPFS gateways used for ipfs://
+// URIs, tried in order until one succeeds.
+func WithIPFSGateways(gateways []string) Option {
+	return func(c *clientConfig) {
+		c.ipfsGateways = gateways
+	}
+}
+
+// WithArweaveGateway overrides the gateway used for ar:// URIs.
+func WithArweaveGateway(gateway string) Option {
+	return func(c *clientConfig) {
+		c.arGateway = gateway
+	}
+}
+
+// WithBatchConcurrency bounds how many jetton lookups GetMastersData and
+// GetMastersByWallets run at once.
+func WithBatchConcurrency(n int) Option {
+	return func(c *clientConfig) {
+		c.batchConcurrency = n
+	}
+}
+
+// WithLogger routes diagnostics (e.g. an IPFS gateway failing over to the
+// next one) through logger instead of discarding them.
+func WithLogger(logger Logger) Option {
+	return func(c *clientConfig) {
+		c.logger = logger
+	}
+}
+
+// NewClient builds a Client with the given options applied on top of the
+// package defaults: an in-memory cache, a 10s HTTP timeout, a 2MiB max
+// response body, and built-in http(s)/ipfs/ar/data fetchers.
+func NewClient(opts ...Option) *Client {
+	cfg := &clientConfig{
+		httpTimeout:      defaultHTTPTimeout,
+		maxBodySize:      defaultMaxBodySize,
+		cacheTTL:         defaultCacheTTL,
+		ipfsGateways:     defaultIPFSGateways,
+		arGateway:        defaultArweaveGateway,
+		fetchers:         make(map[string]URIFetcher),
+		batchConcurrency: defaultBatchConcurrency,
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if cfg.logger == nil {
+		cfg.logger = noopLogger{}
+	}
+
+	httpClient := &httpFetcher{client: &http.Client{Timeout: cfg.httpTimeout}}
+
+	if _, ok := cfg.fetchers["http"]; !ok {
+		cfg.fetchers["http"] = httpClient
+	}
+	if _, ok := cfg.fetchers["https"]; !ok {
+		cfg.fetchers["https"] = httpClient
+	}
+	if _, ok := cfg.fetchers["ipfs"]; !ok {
+		cfg.fetchers["ipfs"] = &ipfsFetcher{http: httpClient, gateways: cfg.ipfsGateways, logger: cfg.logger}
+	}
+	if _, ok := cfg.fetchers["ar"]; !ok {
+		cfg.fetchers["ar"] = &arweaveFetcher{http: httpClient, gateway: cfg.arGateway}
+	}
+	if _, ok := cfg.fetchers["data"]; !ok {
+		cfg.fetchers["data"] = dataURIFetcher{}
+	}
+
+	if cfg.cache == nil {
+		cfg.cache = newMemoryCache(defaultCacheExpiration, defaultCacheCleanup)
+	}
+
+	return &Client{
+		resolver: &ContentResolver{
+			fetchers:    cfg.fetchers,
+			cache:       cfg.cache,
+			cacheTTL:    cfg.cacheTTL,
+			maxBodySize: cfg.maxBodySize,
+		},
+		logger:           cfg.logger,
+		batchConcurrency: cfg.batchConcurrency,
+	}
+}
+
+// defaultClient backs the package-level functions (GetMasterData and
+// friends) so existing callers keep working without constructing a Client.
+var defaultClient = NewClient()
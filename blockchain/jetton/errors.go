@@ -0,0 +1,21 @@
+package jetton
+
+import "errors"
+
+var (
+	// ErrContentFetch is returned when off-chain content could not be
+	// fetched or decoded.
+	ErrContentFetch = errors.New("jetton: fetch content")
+
+	// ErrUnknownContentType is returned when a jetton master reports a
+	// content layout this package does not recognize.
+	ErrUnknownContentType = errors.New("jetton: unknown content type")
+
+	// ErrUnsupportedScheme is returned when an off-chain content URI's
+	// scheme has no registered URIFetcher.
+	ErrUnsupportedScheme = errors.New("jetton: unsupported uri scheme")
+
+	// ErrInvalidDecimals is returned when content declares a decimals
+	// value that cannot be parsed as an integer.
+	ErrInvalidDecimals = errors.New("jetton: invalid decimals")
+)
@@ -0,0 +1,134 @@
+package jetton
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// URIFetcher retrieves the raw bytes an off-chain content URI points to.
+// Fetchers are registered per URI scheme (e.g. "http", "ipfs", "ar", "data").
+type URIFetcher interface {
+	Fetch(ctx context.Context, uri string, maxBodySize int64) ([]byte, error)
+}
+
+// httpFetcher handles plain http:// and https:// URIs.
+type httpFetcher struct {
+	client *http.Client
+}
+
+func (f *httpFetcher) Fetch(ctx context.Context, uri string, maxBodySize int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do get request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status code: %s", resp.Status)
+	}
+
+	return readLimited(resp.Body, maxBodySize)
+}
+
+// ipfsFetcher resolves ipfs://<cid>/<path> URIs against a list of public
+// gateways, trying each in turn until one responds.
+type ipfsFetcher struct {
+	http     *httpFetcher
+	gateways []string
+	logger   Logger
+}
+
+func (f *ipfsFetcher) Fetch(ctx context.Context, uri string, maxBodySize int64) ([]byte, error) {
+	path := strings.TrimPrefix(uri, "ipfs://")
+	path = strings.TrimPrefix(path, "/ipfs/")
+
+	var lastErr error
+	for _, gateway := range f.gateways {
+		body, err := f.http.Fetch(ctx, strings.TrimSuffix(gateway, "/")+"/"+path, maxBodySize)
+		if err == nil {
+			return body, nil
+		}
+		f.logger.Errorf("ipfs gateway %s failed, trying next: %s", gateway, err)
+		lastErr = err
+	}
+
+	return nil, fmt.Errorf("all ipfs gateways failed, last error: %w", lastErr)
+}
+
+// arweaveFetcher resolves ar://<txid> URIs against the Arweave gateway.
+type arweaveFetcher struct {
+	http    *httpFetcher
+	gateway string
+}
+
+func (f *arweaveFetcher) Fetch(ctx context.Context, uri string, maxBodySize int64) ([]byte, error) {
+	txID := strings.TrimPrefix(uri, "ar://")
+	return f.http.Fetch(ctx, strings.TrimSuffix(f.gateway, "/")+"/"+txID, maxBodySize)
+}
+
+// dataURIFetcher decodes RFC 2397 data: URIs, either base64 or
+// percent-encoded, without making any network call.
+type dataURIFetcher struct{}
+
+func (dataURIFetcher) Fetch(_ context.Context, uri string, maxBodySize int64) ([]byte, error) {
+	payload := strings.TrimPrefix(uri, "data:")
+
+	comma := strings.IndexByte(payload, ',')
+	if comma < 0 {
+		return nil, fmt.Errorf("malformed data uri: missing comma")
+	}
+
+	meta, data := payload[:comma], payload[comma+1:]
+
+	var body []byte
+	if strings.HasSuffix(meta, ";base64") {
+		decoded, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return nil, fmt.Errorf("decode base64 data uri: %w", err)
+		}
+		body = decoded
+	} else {
+		decoded, err := url.QueryUnescape(data)
+		if err != nil {
+			return nil, fmt.Errorf("decode percent-encoded data uri: %w", err)
+		}
+		body = []byte(decoded)
+	}
+
+	if maxBodySize > 0 && int64(len(body)) > maxBodySize {
+		return nil, fmt.Errorf("data uri body exceeds max size of %d bytes", maxBodySize)
+	}
+
+	return body, nil
+}
+
+// readLimited reads r up to maxBodySize+1 bytes so it can tell an
+// oversized body apart from one that exactly fits, without buffering an
+// attacker-controlled response in full first.
+func readLimited(r io.Reader, maxBodySize int64) ([]byte, error) {
+	if maxBodySize <= 0 {
+		return io.ReadAll(r)
+	}
+
+	limited := io.LimitReader(r, maxBodySize+1)
+	body, err := io.ReadAll(limited)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	if int64(len(body)) > maxBodySize {
+		return nil, fmt.Errorf("response body exceeds max size of %d bytes", maxBodySize)
+	}
+
+	return body, nil
+}
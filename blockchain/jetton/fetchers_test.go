@@ -0,0 +1,125 @@
+package jetton
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeLogger struct {
+	messages []string
+}
+
+func (l *fakeLogger) Errorf(format string, args ...interface{}) {
+	l.messages = append(l.messages, format)
+}
+
+func TestDataURIFetcherBase64(t *testing.T) {
+	f := dataURIFetcher{}
+
+	body, err := f.Fetch(nil, "data:application/json;base64,eyJuYW1lIjoiRm9vIn0=", 0)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(body) != `{"name":"Foo"}` {
+		t.Errorf("body = %q, want %q", body, `{"name":"Foo"}`)
+	}
+}
+
+func TestDataURIFetcherPercentEncoded(t *testing.T) {
+	f := dataURIFetcher{}
+
+	body, err := f.Fetch(nil, `data:application/json,%7B%22name%22%3A%22Foo%22%7D`, 0)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(body) != `{"name":"Foo"}` {
+		t.Errorf("body = %q, want %q", body, `{"name":"Foo"}`)
+	}
+}
+
+func TestDataURIFetcherMaxBodySize(t *testing.T) {
+	f := dataURIFetcher{}
+
+	_, err := f.Fetch(nil, "data:text/plain,hello world", 4)
+	if err == nil {
+		t.Fatal("expected error when body exceeds max size")
+	}
+}
+
+func TestDataURIFetcherMalformed(t *testing.T) {
+	f := dataURIFetcher{}
+
+	if _, err := f.Fetch(nil, "data:no-comma-here", 0); err == nil {
+		t.Fatal("expected error for data uri with no comma")
+	}
+}
+
+func TestHTTPFetcherMaxBodySize(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("0123456789"))
+	}))
+	defer srv.Close()
+
+	f := &httpFetcher{client: srv.Client()}
+
+	if _, err := f.Fetch(context.Background(), srv.URL, 4); err == nil {
+		t.Fatal("expected error when response exceeds max size")
+	}
+
+	body, err := f.Fetch(context.Background(), srv.URL, 0)
+	if err != nil {
+		t.Fatalf("Fetch with no limit: %v", err)
+	}
+	if string(body) != "0123456789" {
+		t.Errorf("body = %q, want %q", body, "0123456789")
+	}
+}
+
+func TestIPFSFetcherFailover(t *testing.T) {
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer bad.Close()
+
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer good.Close()
+
+	logger := &fakeLogger{}
+	f := &ipfsFetcher{
+		http:     &httpFetcher{client: good.Client()},
+		gateways: []string{bad.URL, good.URL},
+		logger:   logger,
+	}
+
+	body, err := f.Fetch(context.Background(), "ipfs://some-cid/metadata.json", 0)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(body) != "ok" {
+		t.Errorf("body = %q, want %q", body, "ok")
+	}
+	if len(logger.messages) != 1 {
+		t.Errorf("logged %d messages, want 1 (for the failed gateway)", len(logger.messages))
+	}
+}
+
+func TestIPFSFetcherAllGatewaysFail(t *testing.T) {
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer bad.Close()
+
+	f := &ipfsFetcher{
+		http:     &httpFetcher{client: bad.Client()},
+		gateways: []string{bad.URL, bad.URL},
+		logger:   &fakeLogger{},
+	}
+
+	if _, err := f.Fetch(context.Background(), "ipfs://some-cid", 0); err == nil {
+		t.Fatal("expected error when all gateways fail")
+	}
+}
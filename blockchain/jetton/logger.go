@@ -0,0 +1,14 @@
+package jetton
+
+// Logger receives diagnostic messages from Client, e.g. a single IPFS
+// gateway failing over to the next one during off-chain content fetches.
+// Plug in logrus, zap, or anything else behind this interface via
+// WithLogger; the default is a no-op so the package has no logging side
+// effects unless a caller asks for them.
+type Logger interface {
+	Errorf(format string, args ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Errorf(string, ...interface{}) {}
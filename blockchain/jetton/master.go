@@ -4,14 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"strconv"
-	"time"
+	"strings"
 
-	"github.com/moorzeen/common-go/logger"
-	"github.com/patrickmn/go-cache"
-	"github.com/sirupsen/logrus"
 	"github.com/xssnick/tonutils-go/address"
 	"github.com/xssnick/tonutils-go/ton"
 	"github.com/xssnick/tonutils-go/ton/jetton"
@@ -23,9 +18,11 @@ const (
 	contentOnchain   = "onchain"
 	contentSemichain = "semichain"
 	contentOffchain  = "offchain"
-)
 
-var memcache = cache.New(5*time.Minute, 10*time.Minute)
+	// defaultDecimals is what TEP-74/TEP-64 mandate when content omits
+	// "decimals" altogether.
+	defaultDecimals = 9
+)
 
 type MasterData struct {
 	Address     *address.Address
@@ -35,17 +32,63 @@ type MasterData struct {
 	Description string
 	Image       string
 	Decimals    int
+
+	// Attributes holds every well-known TEP-64 key found in the on-chain
+	// dictionary, so callers can reach fields such as "image_data" or
+	// "amount_style" without a dedicated struct field. Off-chain JSON
+	// contributes only "name", "symbol", "description" and "image" (present
+	// even when empty); other off-chain fields are not included.
+	Attributes map[string]string
 }
 
 type OffchainContent struct {
-	Name        string `json:"name"`
-	Symbol      string `json:"symbol"`
-	Description string `json:"description"`
-	Image       string `json:"image"`
-	Decimals    int32  `json:"decimals"`
+	Name        string          `json:"name"`
+	Symbol      string          `json:"symbol"`
+	Description string          `json:"description"`
+	Image       string          `json:"image"`
+	Decimals    json.RawMessage `json:"decimals"`
+}
+
+// decimals returns the content's decimals value, accepting it as either a
+// JSON number or a numeric string per TEP-74, and defaulting to 9 when the
+// field is absent as the spec allows.
+func (o *OffchainContent) decimals() (int, error) {
+	raw := strings.Trim(strings.TrimSpace(string(o.Decimals)), `"`)
+	if raw == "" || raw == "null" {
+		return defaultDecimals, nil
+	}
+
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q: %s", ErrInvalidDecimals, raw, err)
+	}
+
+	return n, nil
 }
 
+// parseDecimals parses an on-chain "decimals" attribute, which is a plain
+// snake-cell string rather than JSON, defaulting to 9 when absent.
+func parseDecimals(raw string) (int, error) {
+	if raw == "" {
+		return defaultDecimals, nil
+	}
+
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q: %s", ErrInvalidDecimals, raw, err)
+	}
+
+	return n, nil
+}
+
+// GetMasterData fetches jetton master metadata using the package's default
+// Client. Use NewClient if you need custom off-chain fetchers, caching, or
+// timeouts.
 func GetMasterData(ctx context.Context, api ton.APIClientWrapped, master *address.Address) (*MasterData, error) {
+	return defaultClient.GetMasterData(ctx, api, master)
+}
+
+func (c *Client) GetMasterData(ctx context.Context, api ton.APIClientWrapped, master *address.Address) (*MasterData, error) {
 	mc := jetton.NewJettonMasterClient(api, master)
 
 	data, err := mc.GetJettonData(ctx)
@@ -53,58 +96,87 @@ func GetMasterData(ctx context.Context, api ton.APIClientWrapped, master *addres
 		return nil, fmt.Errorf("failed to get jetton data: %w", err)
 	}
 
-	var contentType, name, symbol, description, image, decimals string
+	var contentType, name, symbol, description, image string
+	var attrs map[string]string
+	dec := defaultDecimals
 
 	switch data.Content.(type) {
 
 	case *nft.ContentOnchain:
 		content := data.Content.(*nft.ContentOnchain)
 		contentType = contentOnchain
-		name = content.GetAttribute("name")
-		symbol = content.GetAttribute("symbol")
-		description = content.GetAttribute("description")
-		image = content.GetAttribute("image")
-		decimals = content.GetAttribute("decimals")
+
+		attrs = parseOnchainAttributes(content)
+		name = attrs["name"]
+		symbol = attrs["symbol"]
+		description = attrs["description"]
+		image = attrs["image"]
+
+		dec, err = parseDecimals(attrs["decimals"])
+		if err != nil {
+			return nil, err
+		}
 
 	case *nft.ContentSemichain:
 		content := data.Content.(*nft.ContentSemichain)
 		contentType = contentSemichain
 
-		result, err := cachedOffchainContent(content.URI)
+		result, err := c.resolver.Resolve(ctx, content.URI)
 		if err != nil {
-			logrus.Errorf("fetch cashed offchain content: %s", err)
-			break
+			return nil, err
 		}
 
-		name = result.Name
-		symbol = result.Symbol
-		description = result.Description
-		image = result.Image
-		decimals = content.GetAttribute("decimals")
+		attrs = map[string]string{
+			"name":        result.Name,
+			"symbol":      result.Symbol,
+			"description": result.Description,
+			"image":       result.Image,
+		}
+
+		// TEP-64 semantics: on-chain overrides win over the off-chain
+		// JSON for any key they define.
+		for key, value := range parseOnchainAttributes(content.ContentOnchain) {
+			attrs[key] = value
+		}
+
+		name = attrs["name"]
+		symbol = attrs["symbol"]
+		description = attrs["description"]
+		image = attrs["image"]
+
+		dec, err = decimalsFor(attrs["decimals"], result)
+		if err != nil {
+			return nil, err
+		}
 
 	case *nft.ContentOffchain:
 		content := data.Content.(*nft.ContentOffchain)
 		contentType = contentOffchain
 
-		result, err := cachedOffchainContent(content.URI)
+		result, err := c.resolver.Resolve(ctx, content.URI)
 		if err != nil {
-			logrus.Errorf("fetch cashed offchain content: %s", err)
-			break
+			return nil, err
+		}
+
+		attrs = map[string]string{
+			"name":        result.Name,
+			"symbol":      result.Symbol,
+			"description": result.Description,
+			"image":       result.Image,
 		}
 
 		name = result.Name
 		symbol = result.Symbol
 		description = result.Description
 		image = result.Image
-		decimals = string(result.Decimals)
 
-	default:
-		logrus.Error("unknown content type")
-	}
+		dec, err = result.decimals()
+		if err != nil {
+			return nil, err
+		}
 
-	dec, err := strconv.Atoi(decimals)
-	if err != nil {
-		logrus.Errorf("convert decimals: %s", err)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnknownContentType, data.Content)
 	}
 
 	return &MasterData{
@@ -115,10 +187,38 @@ func GetMasterData(ctx context.Context, api ton.APIClientWrapped, master *addres
 		Description: description,
 		Image:       image,
 		Decimals:    dec,
-	}, err
+		Attributes:  attrs,
+	}, nil
+}
+
+// MustGetMasterData is like GetMasterData but panics on error, kept for
+// callers that would rather crash than handle the error themselves.
+func MustGetMasterData(ctx context.Context, api ton.APIClientWrapped, master *address.Address) *MasterData {
+	return defaultClient.MustGetMasterData(ctx, api, master)
+}
+
+func (c *Client) MustGetMasterData(ctx context.Context, api ton.APIClientWrapped, master *address.Address) *MasterData {
+	data, err := c.GetMasterData(ctx, api, master)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// decimalsFor picks the semichain decimals value: the on-chain attribute, if
+// present, otherwise the off-chain JSON's value.
+func decimalsFor(onchain string, offchain *OffchainContent) (int, error) {
+	if onchain != "" {
+		return parseDecimals(onchain)
+	}
+	return offchain.decimals()
 }
 
 func GetMasterByWallet(ctx context.Context, api ton.APIClientWrapped, jettonWallet *address.Address) (*MasterData, error) {
+	return defaultClient.GetMasterByWallet(ctx, api, jettonWallet)
+}
+
+func (c *Client) GetMasterByWallet(ctx context.Context, api ton.APIClientWrapped, jettonWallet *address.Address) (*MasterData, error) {
 	b, err := api.CurrentMasterchainInfo(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("get current master chain info: %w", err)
@@ -131,8 +231,8 @@ func GetMasterByWallet(ctx context.Context, api ton.APIClientWrapped, jettonWall
 
 	master := &address.Address{}
 
-	for _, c := range res.AsTuple() {
-		switch res := c.(type) {
+	for _, item := range res.AsTuple() {
+		switch res := item.(type) {
 		case *cell.Slice:
 			master, err = res.LoadAddr()
 			if err != nil {
@@ -143,53 +243,12 @@ func GetMasterByWallet(ctx context.Context, api ton.APIClientWrapped, jettonWall
 		}
 	}
 
-	data, err := GetMasterData(ctx, api, master)
+	v, err, _ := c.masterGroup.Do(master.String(), func() (interface{}, error) {
+		return c.GetMasterData(ctx, api, master)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get master data: %w", err)
 	}
 
-	return data, nil
-}
-
-func cachedOffchainContent(uri string) (*OffchainContent, error) {
-	if cached, ok := memcache.Get(uri); ok {
-		fmt.Println("got from cache", logger.AnyPrint(cached))
-		return cached.(*OffchainContent), nil
-	}
-
-	result, err := fetchOffchainContent(uri)
-	if err != nil {
-		return nil, fmt.Errorf("fetch offchain content: %w", err)
-	}
-
-	memcache.Set(uri, result, time.Hour)
-	fmt.Println("set cache", logger.AnyPrint(result))
-
-	return result, nil
-}
-
-func fetchOffchainContent(uri string) (*OffchainContent, error) {
-	resp, err := http.Get(uri)
-	if err != nil {
-		return nil, fmt.Errorf("do get request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected response status code: %s", resp.Status)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("read response body: %w", err)
-	}
-
-	result := &OffchainContent{}
-
-	err = json.Unmarshal(body, result)
-	if err != nil {
-		return nil, fmt.Errorf("unmarshal response body: %w", err)
-	}
-
-	return result, nil
+	return v.(*MasterData), nil
 }
@@ -0,0 +1,76 @@
+package jetton
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestOffchainContentDecimals(t *testing.T) {
+	cases := []struct {
+		name    string
+		json    string
+		want    int
+		wantErr bool
+	}{
+		{name: "number", json: `{"decimals":9}`, want: 9},
+		{name: "string", json: `{"decimals":"6"}`, want: 6},
+		{name: "absent defaults to 9", json: `{}`, want: defaultDecimals},
+		{name: "null defaults to 9", json: `{"decimals":null}`, want: defaultDecimals},
+		{name: "invalid", json: `{"decimals":"not-a-number"}`, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var o OffchainContent
+			if err := json.Unmarshal([]byte(tc.json), &o); err != nil {
+				t.Fatalf("unmarshal fixture: %v", err)
+			}
+
+			got, err := o.decimals()
+			if tc.wantErr {
+				if !errors.Is(err, ErrInvalidDecimals) {
+					t.Fatalf("decimals() err = %v, want ErrInvalidDecimals", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decimals(): %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("decimals() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseDecimals(t *testing.T) {
+	cases := []struct {
+		name    string
+		raw     string
+		want    int
+		wantErr bool
+	}{
+		{name: "absent defaults to 9", raw: "", want: defaultDecimals},
+		{name: "valid", raw: "9", want: 9},
+		{name: "invalid", raw: "nine", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseDecimals(tc.raw)
+			if tc.wantErr {
+				if !errors.Is(err, ErrInvalidDecimals) {
+					t.Fatalf("parseDecimals() err = %v, want ErrInvalidDecimals", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseDecimals(): %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("parseDecimals() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package jetton
+
+import "github.com/xssnick/tonutils-go/ton/nft"
+
+// onchainAttributeKeys lists the TEP-64 attribute names this package knows
+// how to extract from an on-chain content dictionary.
+var onchainAttributeKeys = []string{
+	"name",
+	"symbol",
+	"description",
+	"image",
+	"image_data",
+	"decimals",
+	"uri",
+	"amount_style",
+	"render_type",
+}
+
+// parseOnchainAttributes walks the well-known TEP-64 keys and delegates the
+// SHA-256 dict key computation and snake-cell decoding (both the
+// 0x00-prefixed snake format and the 0x01-prefixed chunk dictionary format)
+// to nft.ContentOnchain.GetAttribute, collecting every key it finds a value
+// for into a map.
+func parseOnchainAttributes(content *nft.ContentOnchain) map[string]string {
+	attrs := make(map[string]string, len(onchainAttributeKeys))
+
+	for _, key := range onchainAttributeKeys {
+		value := content.GetAttribute(key)
+		if value == "" {
+			continue
+		}
+		attrs[key] = value
+	}
+
+	return attrs
+}
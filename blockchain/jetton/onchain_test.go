@@ -0,0 +1,43 @@
+package jetton
+
+import (
+	"testing"
+
+	"github.com/xssnick/tonutils-go/ton/nft"
+)
+
+func TestParseOnchainAttributes(t *testing.T) {
+	content := &nft.ContentOnchain{Data: map[string]string{
+		"name":     "Test Jetton",
+		"symbol":   "TST",
+		"decimals": "6",
+		"unknown":  "not a TEP-64 key we look for",
+	}}
+
+	attrs := parseOnchainAttributes(content)
+
+	want := map[string]string{
+		"name":     "Test Jetton",
+		"symbol":   "TST",
+		"decimals": "6",
+	}
+	for key, value := range want {
+		if attrs[key] != value {
+			t.Errorf("attrs[%q] = %q, want %q", key, attrs[key], value)
+		}
+	}
+
+	if _, ok := attrs["unknown"]; ok {
+		t.Errorf("attrs contains %q, want only well-known TEP-64 keys", "unknown")
+	}
+	if _, ok := attrs["image"]; ok {
+		t.Errorf("attrs contains %q, which was never set on content", "image")
+	}
+}
+
+func TestParseOnchainAttributesEmpty(t *testing.T) {
+	attrs := parseOnchainAttributes(&nft.ContentOnchain{Data: map[string]string{}})
+	if len(attrs) != 0 {
+		t.Errorf("attrs = %v, want empty map", attrs)
+	}
+}
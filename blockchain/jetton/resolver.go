@@ -0,0 +1,57 @@
+package jetton
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ContentResolver fetches and caches off-chain jetton content, dispatching
+// to a URIFetcher by the URI's scheme.
+type ContentResolver struct {
+	fetchers    map[string]URIFetcher
+	cache       Cache
+	cacheTTL    time.Duration
+	maxBodySize int64
+}
+
+// Resolve fetches the off-chain JSON content at uri, serving it from cache
+// when possible.
+func (r *ContentResolver) Resolve(ctx context.Context, uri string) (*OffchainContent, error) {
+	if cached, ok := r.cache.Get(uri); ok {
+		return cached, nil
+	}
+
+	fetcher, ok := r.fetchers[schemeOf(uri)]
+	if !ok {
+		return nil, fmt.Errorf("%w: no fetcher registered for uri %q", ErrUnsupportedScheme, uri)
+	}
+
+	body, err := fetcher.Fetch(ctx, uri, r.maxBodySize)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrContentFetch, err)
+	}
+
+	result := &OffchainContent{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, fmt.Errorf("%w: unmarshal response body: %s", ErrContentFetch, err)
+	}
+
+	r.cache.Set(uri, result, r.cacheTTL)
+
+	return result, nil
+}
+
+// schemeOf returns the lowercased URI scheme, defaulting to "http" for bare
+// host/path strings with no scheme at all. "http" and "https" are returned
+// as-is, but NewClient and WithFetcher always register the same fetcher
+// under both.
+func schemeOf(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme == "" {
+		return "http"
+	}
+	return u.Scheme
+}
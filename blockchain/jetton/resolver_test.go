@@ -0,0 +1,98 @@
+package jetton
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeFetcher struct {
+	calls int
+	body  []byte
+	err   error
+}
+
+func (f *fakeFetcher) Fetch(context.Context, string, int64) ([]byte, error) {
+	f.calls++
+	return f.body, f.err
+}
+
+func TestContentResolverResolve(t *testing.T) {
+	fetcher := &fakeFetcher{body: []byte(`{"name":"Foo","symbol":"FOO","decimals":9}`)}
+	r := &ContentResolver{
+		fetchers: map[string]URIFetcher{"http": fetcher},
+		cache:    newMemoryCache(time.Minute, time.Minute),
+		cacheTTL: time.Minute,
+	}
+
+	got, err := r.Resolve(context.Background(), "http://example.com/meta.json")
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if got.Name != "Foo" || got.Symbol != "FOO" {
+		t.Errorf("Resolve = %+v, want Name=Foo Symbol=FOO", got)
+	}
+
+	// Second call for the same URI should be served from cache, not refetched.
+	if _, err := r.Resolve(context.Background(), "http://example.com/meta.json"); err != nil {
+		t.Fatalf("Resolve (cached): %v", err)
+	}
+	if fetcher.calls != 1 {
+		t.Errorf("fetcher called %d times, want 1 (second call should hit cache)", fetcher.calls)
+	}
+}
+
+func TestContentResolverUnsupportedScheme(t *testing.T) {
+	r := &ContentResolver{
+		fetchers: map[string]URIFetcher{"http": &fakeFetcher{}},
+		cache:    newMemoryCache(time.Minute, time.Minute),
+	}
+
+	_, err := r.Resolve(context.Background(), "ftp://example.com/meta.json")
+	if !errors.Is(err, ErrUnsupportedScheme) {
+		t.Fatalf("err = %v, want ErrUnsupportedScheme", err)
+	}
+}
+
+func TestContentResolverFetchError(t *testing.T) {
+	r := &ContentResolver{
+		fetchers: map[string]URIFetcher{"http": &fakeFetcher{err: errors.New("boom")}},
+		cache:    newMemoryCache(time.Minute, time.Minute),
+	}
+
+	_, err := r.Resolve(context.Background(), "http://example.com/meta.json")
+	if !errors.Is(err, ErrContentFetch) {
+		t.Fatalf("err = %v, want ErrContentFetch", err)
+	}
+}
+
+func TestContentResolverMalformedJSON(t *testing.T) {
+	r := &ContentResolver{
+		fetchers: map[string]URIFetcher{"http": &fakeFetcher{body: []byte("not json")}},
+		cache:    newMemoryCache(time.Minute, time.Minute),
+	}
+
+	_, err := r.Resolve(context.Background(), "http://example.com/meta.json")
+	if !errors.Is(err, ErrContentFetch) {
+		t.Fatalf("err = %v, want ErrContentFetch", err)
+	}
+}
+
+func TestSchemeOf(t *testing.T) {
+	cases := map[string]string{
+		"http://example.com/a":  "http",
+		"https://example.com/a": "https",
+		"ipfs://cid/a":          "ipfs",
+		"ar://txid":             "ar",
+		"data:text/plain,hi":    "data",
+		"example.com/a":         "http",
+		"":                      "http",
+	}
+
+	for uri, want := range cases {
+		if got := schemeOf(uri); got != want {
+			t.Errorf("schemeOf(%q) = %q, want %q", uri, got, want)
+		}
+	}
+}
@@ -0,0 +1,458 @@
+package jetton
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+)
+
+// TEP-74 operation codes.
+const (
+	opTransfer             = 0x0f8a7ea5
+	opBurn                 = 0x595f07bc
+	opInternalTransfer     = 0x178d4519
+	opTransferNotification = 0x7362d09c
+)
+
+// TransferOpts configures a TEP-74 "transfer" message body.
+type TransferOpts struct {
+	QueryID             uint64
+	Amount              tlb.Coins
+	Destination         *address.Address
+	ResponseDestination *address.Address
+	CustomPayload       *cell.Cell
+	ForwardTONAmount    tlb.Coins
+	// ForwardPayload is stored as a separate ref when set; TEP-74 also
+	// allows it inline, but a ref is always valid and simpler to build.
+	ForwardPayload *cell.Cell
+}
+
+// TransferPayload is the parsed form of a TEP-74 "transfer" message body.
+type TransferPayload struct {
+	QueryID             uint64
+	Amount              tlb.Coins
+	Destination         *address.Address
+	ResponseDestination *address.Address
+	CustomPayload       *cell.Cell
+	ForwardTONAmount    tlb.Coins
+	ForwardPayload      *cell.Cell
+}
+
+// BuildTransferPayload builds the body of a TEP-74 "transfer" internal
+// message (op 0x0f8a7ea5), the message a jetton wallet owner sends to move
+// jettons to another wallet.
+func BuildTransferPayload(opts TransferOpts) (*cell.Cell, error) {
+	if opts.Destination == nil {
+		return nil, fmt.Errorf("jetton: build transfer: destination is required")
+	}
+	if opts.ResponseDestination == nil {
+		return nil, fmt.Errorf("jetton: build transfer: response destination is required")
+	}
+
+	b := cell.BeginCell().
+		MustStoreUInt(opTransfer, 32).
+		MustStoreUInt(opts.QueryID, 64).
+		MustStoreBigCoins(opts.Amount.Nano()).
+		MustStoreAddr(opts.Destination).
+		MustStoreAddr(opts.ResponseDestination).
+		MustStoreBoolBit(opts.CustomPayload != nil)
+
+	if opts.CustomPayload != nil {
+		b.MustStoreRef(opts.CustomPayload)
+	}
+
+	b.MustStoreBigCoins(opts.ForwardTONAmount.Nano()).
+		MustStoreBoolBit(opts.ForwardPayload != nil)
+
+	if opts.ForwardPayload != nil {
+		b.MustStoreRef(opts.ForwardPayload)
+	}
+
+	return b.EndCell(), nil
+}
+
+// ParseTransferPayload parses the body of a TEP-74 "transfer" message.
+func ParseTransferPayload(body *cell.Slice) (*TransferPayload, error) {
+	op, err := body.LoadUInt(32)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer: load op: %w", err)
+	}
+	if op != opTransfer {
+		return nil, fmt.Errorf("jetton: parse transfer: unexpected op 0x%x", op)
+	}
+
+	queryID, err := body.LoadUInt(64)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer: load query id: %w", err)
+	}
+
+	amount, err := body.LoadBigCoins()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer: load amount: %w", err)
+	}
+
+	dest, err := body.LoadAddr()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer: load destination: %w", err)
+	}
+
+	respDest, err := body.LoadAddr()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer: load response destination: %w", err)
+	}
+
+	customPayload, err := loadMaybeRefCell(body)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer: load custom payload: %w", err)
+	}
+
+	forwardAmount, err := body.LoadBigCoins()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer: load forward ton amount: %w", err)
+	}
+
+	forwardPayload, err := loadEitherPayload(body)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer: load forward payload: %w", err)
+	}
+
+	return &TransferPayload{
+		QueryID:             queryID,
+		Amount:              tlb.FromNanoTON(amount),
+		Destination:         dest,
+		ResponseDestination: respDest,
+		CustomPayload:       customPayload,
+		ForwardTONAmount:    tlb.FromNanoTON(forwardAmount),
+		ForwardPayload:      forwardPayload,
+	}, nil
+}
+
+// BurnOpts configures a TEP-74 "burn" message body.
+type BurnOpts struct {
+	QueryID             uint64
+	Amount              tlb.Coins
+	ResponseDestination *address.Address
+	CustomPayload       *cell.Cell
+}
+
+// BurnPayload is the parsed form of a TEP-74 "burn" message body.
+type BurnPayload struct {
+	QueryID             uint64
+	Amount              tlb.Coins
+	ResponseDestination *address.Address
+	CustomPayload       *cell.Cell
+}
+
+// BuildBurnPayload builds the body of a TEP-74 "burn" internal message
+// (op 0x595f07bc).
+func BuildBurnPayload(opts BurnOpts) (*cell.Cell, error) {
+	if opts.ResponseDestination == nil {
+		return nil, fmt.Errorf("jetton: build burn: response destination is required")
+	}
+
+	b := cell.BeginCell().
+		MustStoreUInt(opBurn, 32).
+		MustStoreUInt(opts.QueryID, 64).
+		MustStoreBigCoins(opts.Amount.Nano()).
+		MustStoreAddr(opts.ResponseDestination).
+		MustStoreBoolBit(opts.CustomPayload != nil)
+
+	if opts.CustomPayload != nil {
+		b.MustStoreRef(opts.CustomPayload)
+	}
+
+	return b.EndCell(), nil
+}
+
+// ParseBurnPayload parses the body of a TEP-74 "burn" message.
+func ParseBurnPayload(body *cell.Slice) (*BurnPayload, error) {
+	op, err := body.LoadUInt(32)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse burn: load op: %w", err)
+	}
+	if op != opBurn {
+		return nil, fmt.Errorf("jetton: parse burn: unexpected op 0x%x", op)
+	}
+
+	queryID, err := body.LoadUInt(64)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse burn: load query id: %w", err)
+	}
+
+	amount, err := body.LoadBigCoins()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse burn: load amount: %w", err)
+	}
+
+	respDest, err := body.LoadAddr()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse burn: load response destination: %w", err)
+	}
+
+	customPayload, err := loadMaybeRefCell(body)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse burn: load custom payload: %w", err)
+	}
+
+	return &BurnPayload{
+		QueryID:             queryID,
+		Amount:              tlb.FromNanoTON(amount),
+		ResponseDestination: respDest,
+		CustomPayload:       customPayload,
+	}, nil
+}
+
+// InternalTransferOpts configures a TEP-74 "internal_transfer" message
+// body, sent wallet-to-wallet to move jettons after a "transfer" is
+// received.
+type InternalTransferOpts struct {
+	QueryID          uint64
+	Amount           tlb.Coins
+	From             *address.Address
+	ResponseAddress  *address.Address
+	ForwardTONAmount tlb.Coins
+	ForwardPayload   *cell.Cell
+}
+
+// InternalTransferPayload is the parsed form of a TEP-74
+// "internal_transfer" message body.
+type InternalTransferPayload struct {
+	QueryID          uint64
+	Amount           tlb.Coins
+	From             *address.Address
+	ResponseAddress  *address.Address
+	ForwardTONAmount tlb.Coins
+	ForwardPayload   *cell.Cell
+}
+
+// BuildInternalTransferPayload builds the body of a TEP-74
+// "internal_transfer" internal message (op 0x178d4519).
+func BuildInternalTransferPayload(opts InternalTransferOpts) (*cell.Cell, error) {
+	if opts.From == nil {
+		return nil, fmt.Errorf("jetton: build internal transfer: from is required")
+	}
+	if opts.ResponseAddress == nil {
+		return nil, fmt.Errorf("jetton: build internal transfer: response address is required")
+	}
+
+	b := cell.BeginCell().
+		MustStoreUInt(opInternalTransfer, 32).
+		MustStoreUInt(opts.QueryID, 64).
+		MustStoreBigCoins(opts.Amount.Nano()).
+		MustStoreAddr(opts.From).
+		MustStoreAddr(opts.ResponseAddress).
+		MustStoreBigCoins(opts.ForwardTONAmount.Nano()).
+		MustStoreBoolBit(opts.ForwardPayload != nil)
+
+	if opts.ForwardPayload != nil {
+		b.MustStoreRef(opts.ForwardPayload)
+	}
+
+	return b.EndCell(), nil
+}
+
+// ParseInternalTransferPayload parses the body of a TEP-74
+// "internal_transfer" message.
+func ParseInternalTransferPayload(body *cell.Slice) (*InternalTransferPayload, error) {
+	op, err := body.LoadUInt(32)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse internal transfer: load op: %w", err)
+	}
+	if op != opInternalTransfer {
+		return nil, fmt.Errorf("jetton: parse internal transfer: unexpected op 0x%x", op)
+	}
+
+	queryID, err := body.LoadUInt(64)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse internal transfer: load query id: %w", err)
+	}
+
+	amount, err := body.LoadBigCoins()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse internal transfer: load amount: %w", err)
+	}
+
+	from, err := body.LoadAddr()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse internal transfer: load from: %w", err)
+	}
+
+	respAddr, err := body.LoadAddr()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse internal transfer: load response address: %w", err)
+	}
+
+	forwardAmount, err := body.LoadBigCoins()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse internal transfer: load forward ton amount: %w", err)
+	}
+
+	forwardPayload, err := loadEitherPayload(body)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse internal transfer: load forward payload: %w", err)
+	}
+
+	return &InternalTransferPayload{
+		QueryID:          queryID,
+		Amount:           tlb.FromNanoTON(amount),
+		From:             from,
+		ResponseAddress:  respAddr,
+		ForwardTONAmount: tlb.FromNanoTON(forwardAmount),
+		ForwardPayload:   forwardPayload,
+	}, nil
+}
+
+// TransferNotificationOpts configures a TEP-74 "transfer_notification"
+// message body, sent to the new owner's wallet so it (or its contract
+// logic) can react to an incoming transfer.
+type TransferNotificationOpts struct {
+	QueryID        uint64
+	Amount         tlb.Coins
+	Sender         *address.Address
+	ForwardPayload *cell.Cell
+}
+
+// TransferNotificationPayload is the parsed form of a TEP-74
+// "transfer_notification" message body.
+type TransferNotificationPayload struct {
+	QueryID        uint64
+	Amount         tlb.Coins
+	Sender         *address.Address
+	ForwardPayload *cell.Cell
+}
+
+// BuildTransferNotificationPayload builds the body of a TEP-74
+// "transfer_notification" internal message (op 0x7362d09c).
+func BuildTransferNotificationPayload(opts TransferNotificationOpts) (*cell.Cell, error) {
+	if opts.Sender == nil {
+		return nil, fmt.Errorf("jetton: build transfer notification: sender is required")
+	}
+
+	b := cell.BeginCell().
+		MustStoreUInt(opTransferNotification, 32).
+		MustStoreUInt(opts.QueryID, 64).
+		MustStoreBigCoins(opts.Amount.Nano()).
+		MustStoreAddr(opts.Sender).
+		MustStoreBoolBit(opts.ForwardPayload != nil)
+
+	if opts.ForwardPayload != nil {
+		b.MustStoreRef(opts.ForwardPayload)
+	}
+
+	return b.EndCell(), nil
+}
+
+// ParseTransferNotificationPayload parses the body of a TEP-74
+// "transfer_notification" message.
+func ParseTransferNotificationPayload(body *cell.Slice) (*TransferNotificationPayload, error) {
+	op, err := body.LoadUInt(32)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer notification: load op: %w", err)
+	}
+	if op != opTransferNotification {
+		return nil, fmt.Errorf("jetton: parse transfer notification: unexpected op 0x%x", op)
+	}
+
+	queryID, err := body.LoadUInt(64)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer notification: load query id: %w", err)
+	}
+
+	amount, err := body.LoadBigCoins()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer notification: load amount: %w", err)
+	}
+
+	sender, err := body.LoadAddr()
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer notification: load sender: %w", err)
+	}
+
+	forwardPayload, err := loadEitherPayload(body)
+	if err != nil {
+		return nil, fmt.Errorf("jetton: parse transfer notification: load forward payload: %w", err)
+	}
+
+	return &TransferNotificationPayload{
+		QueryID:        queryID,
+		Amount:         tlb.FromNanoTON(amount),
+		Sender:         sender,
+		ForwardPayload: forwardPayload,
+	}, nil
+}
+
+// loadMaybeRefCell loads a TL-B "(Maybe ^Cell)": a bool bit followed by a
+// ref cell when set.
+func loadMaybeRefCell(body *cell.Slice) (*cell.Cell, error) {
+	has, err := body.LoadBoolBit()
+	if err != nil {
+		return nil, fmt.Errorf("load maybe bit: %w", err)
+	}
+	if !has {
+		return nil, nil
+	}
+
+	ref, err := body.LoadRef()
+	if err != nil {
+		return nil, fmt.Errorf("load ref: %w", err)
+	}
+
+	return ref.ToCell()
+}
+
+// loadEitherPayload loads a TL-B "(Either Cell ^Cell)" as used for
+// forward_payload: a bool bit selects whether the payload is stored inline
+// in the remainder of the current cell, or as a separate ref.
+func loadEitherPayload(body *cell.Slice) (*cell.Cell, error) {
+	inRef, err := body.LoadBoolBit()
+	if err != nil {
+		return nil, fmt.Errorf("load either bit: %w", err)
+	}
+
+	if !inRef {
+		return body.ToCell()
+	}
+
+	ref, err := body.LoadRef()
+	if err != nil {
+		return nil, fmt.Errorf("load ref: %w", err)
+	}
+
+	return ref.ToCell()
+}
+
+// GetWalletAddress calls "get_wallet_address" on the jetton master contract
+// to resolve the jetton wallet address the given owner holds for it.
+func GetWalletAddress(ctx context.Context, api ton.APIClientWrapped, master, owner *address.Address) (*address.Address, error) {
+	b, err := api.CurrentMasterchainInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get current master chain info: %w", err)
+	}
+
+	ownerSlice := cell.BeginCell().MustStoreAddr(owner).EndCell().BeginParse()
+
+	res, err := api.RunGetMethod(ctx, b, master, "get_wallet_address", ownerSlice)
+	if err != nil {
+		return nil, fmt.Errorf("run get method: %w", err)
+	}
+
+	wallet := &address.Address{}
+
+	for _, item := range res.AsTuple() {
+		switch s := item.(type) {
+		case *cell.Slice:
+			wallet, err = s.LoadAddr()
+			if err != nil {
+				return nil, fmt.Errorf("load wallet address: %w", err)
+			}
+		default:
+
+		}
+	}
+
+	return wallet, nil
+}
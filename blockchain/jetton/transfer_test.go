@@ -0,0 +1,202 @@
+package jetton
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+)
+
+func testAddr(last byte) *address.Address {
+	key := make([]byte, 32)
+	key[31] = last
+	return address.NewAddress(0, 0, key)
+}
+
+func testPayloadCell(tag byte) *cell.Cell {
+	return cell.BeginCell().MustStoreUInt(uint64(tag), 8).EndCell()
+}
+
+func TestBuildParseTransferPayload(t *testing.T) {
+	cases := []struct {
+		name           string
+		customPayload  *cell.Cell
+		forwardPayload *cell.Cell
+	}{
+		{name: "no optional payloads"},
+		{name: "custom payload only", customPayload: testPayloadCell(1)},
+		{name: "forward payload only", forwardPayload: testPayloadCell(2)},
+		{name: "both payloads", customPayload: testPayloadCell(1), forwardPayload: testPayloadCell(2)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := TransferOpts{
+				QueryID:             42,
+				Amount:              tlb.FromNanoTON(big.NewInt(1_000_000_000)),
+				Destination:         testAddr(1),
+				ResponseDestination: testAddr(2),
+				CustomPayload:       tc.customPayload,
+				ForwardTONAmount:    tlb.FromNanoTON(big.NewInt(1)),
+				ForwardPayload:      tc.forwardPayload,
+			}
+
+			body, err := BuildTransferPayload(opts)
+			if err != nil {
+				t.Fatalf("BuildTransferPayload: %v", err)
+			}
+
+			got, err := ParseTransferPayload(body.BeginParse())
+			if err != nil {
+				t.Fatalf("ParseTransferPayload: %v", err)
+			}
+
+			if got.QueryID != opts.QueryID {
+				t.Errorf("QueryID = %d, want %d", got.QueryID, opts.QueryID)
+			}
+			if got.Amount.Nano().Cmp(opts.Amount.Nano()) != 0 {
+				t.Errorf("Amount = %s, want %s", got.Amount.Nano(), opts.Amount.Nano())
+			}
+			if !got.Destination.Equals(opts.Destination) {
+				t.Errorf("Destination = %s, want %s", got.Destination, opts.Destination)
+			}
+			if !got.ResponseDestination.Equals(opts.ResponseDestination) {
+				t.Errorf("ResponseDestination = %s, want %s", got.ResponseDestination, opts.ResponseDestination)
+			}
+
+			// custom_payload is a TL-B Maybe: absent on build round-trips to a
+			// nil *cell.Cell on parse too.
+			if tc.customPayload == nil {
+				if got.CustomPayload != nil {
+					t.Errorf("CustomPayload = %v, want nil", got.CustomPayload)
+				}
+			} else if got.CustomPayload == nil {
+				t.Errorf("CustomPayload round-trip lost the cell")
+			}
+
+			// forward_payload is a TL-B Either: absent on build does NOT
+			// round-trip to nil, it round-trips to a non-nil empty cell,
+			// since the "inline" branch reads whatever bits/refs remain.
+			if tc.forwardPayload == nil {
+				if got.ForwardPayload == nil {
+					t.Errorf("ForwardPayload = nil, want non-nil empty cell")
+				}
+			} else if got.ForwardPayload == nil {
+				t.Errorf("ForwardPayload round-trip lost the cell")
+			}
+		})
+	}
+}
+
+func TestBuildTransferPayloadRequiresAddresses(t *testing.T) {
+	base := TransferOpts{
+		Amount:              tlb.FromNanoTON(big.NewInt(1)),
+		Destination:         testAddr(1),
+		ResponseDestination: testAddr(2),
+	}
+
+	noDest := base
+	noDest.Destination = nil
+	if _, err := BuildTransferPayload(noDest); err == nil {
+		t.Error("expected error when destination is nil")
+	}
+
+	noResp := base
+	noResp.ResponseDestination = nil
+	if _, err := BuildTransferPayload(noResp); err == nil {
+		t.Error("expected error when response destination is nil")
+	}
+}
+
+func TestBuildParseBurnPayload(t *testing.T) {
+	cases := []struct {
+		name          string
+		customPayload *cell.Cell
+	}{
+		{name: "no custom payload"},
+		{name: "with custom payload", customPayload: testPayloadCell(1)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := BurnOpts{
+				QueryID:             7,
+				Amount:              tlb.FromNanoTON(big.NewInt(500)),
+				ResponseDestination: testAddr(3),
+				CustomPayload:       tc.customPayload,
+			}
+
+			body, err := BuildBurnPayload(opts)
+			if err != nil {
+				t.Fatalf("BuildBurnPayload: %v", err)
+			}
+
+			got, err := ParseBurnPayload(body.BeginParse())
+			if err != nil {
+				t.Fatalf("ParseBurnPayload: %v", err)
+			}
+
+			if got.QueryID != opts.QueryID {
+				t.Errorf("QueryID = %d, want %d", got.QueryID, opts.QueryID)
+			}
+			if (tc.customPayload == nil) != (got.CustomPayload == nil) {
+				t.Errorf("CustomPayload presence = %v, want %v", got.CustomPayload != nil, tc.customPayload != nil)
+			}
+		})
+	}
+}
+
+func TestBuildParseInternalTransferPayload(t *testing.T) {
+	opts := InternalTransferOpts{
+		QueryID:          11,
+		Amount:           tlb.FromNanoTON(big.NewInt(42)),
+		From:             testAddr(4),
+		ResponseAddress:  testAddr(5),
+		ForwardTONAmount: tlb.FromNanoTON(big.NewInt(1)),
+		ForwardPayload:   testPayloadCell(9),
+	}
+
+	body, err := BuildInternalTransferPayload(opts)
+	if err != nil {
+		t.Fatalf("BuildInternalTransferPayload: %v", err)
+	}
+
+	got, err := ParseInternalTransferPayload(body.BeginParse())
+	if err != nil {
+		t.Fatalf("ParseInternalTransferPayload: %v", err)
+	}
+
+	if !got.From.Equals(opts.From) {
+		t.Errorf("From = %s, want %s", got.From, opts.From)
+	}
+	if got.ForwardPayload == nil {
+		t.Error("ForwardPayload round-trip lost the cell")
+	}
+}
+
+func TestBuildParseTransferNotificationPayload(t *testing.T) {
+	opts := TransferNotificationOpts{
+		QueryID: 99,
+		Amount:  tlb.FromNanoTON(big.NewInt(1234)),
+		Sender:  testAddr(6),
+	}
+
+	body, err := BuildTransferNotificationPayload(opts)
+	if err != nil {
+		t.Fatalf("BuildTransferNotificationPayload: %v", err)
+	}
+
+	got, err := ParseTransferNotificationPayload(body.BeginParse())
+	if err != nil {
+		t.Fatalf("ParseTransferNotificationPayload: %v", err)
+	}
+
+	if !got.Sender.Equals(opts.Sender) {
+		t.Errorf("Sender = %s, want %s", got.Sender, opts.Sender)
+	}
+	if got.ForwardPayload == nil {
+		t.Error("ForwardPayload = nil, want non-nil empty cell (Either-inline round-trip)")
+	}
+}